@@ -0,0 +1,130 @@
+// Package bundle pulls a Tekton Bundle OCI artifact and decodes the
+// Task/Pipeline objects packed into its layers, enforcing the bundle
+// contract Tekton's own bundle resolver expects.
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"sigs.k8s.io/yaml"
+)
+
+// MediaType is the OCI media type Tekton Bundle layers are published under.
+const MediaType = "application/vnd.cdf.tekton.catalog.v1alpha1+yaml"
+
+// KindAnnotation and APIVersionAnnotation are the annotations every layer in
+// a Tekton Bundle must carry so tooling can tell what's packed inside
+// without decoding the YAML first.
+const (
+	KindAnnotation       = "tekton.dev/kind"
+	APIVersionAnnotation = "tekton.dev/apiVersion"
+)
+
+// MaxObjects is the maximum number of objects a Tekton Bundle may contain.
+const MaxObjects = 10
+
+// Object is one Task/Pipeline layer extracted from a bundle, before
+// decoding into a concrete Tekton type.
+type Object struct {
+	Kind       string
+	APIVersion string
+	Name       string
+	YAML       []byte
+}
+
+// Bundle is a pulled OCI artifact's digest plus the objects packed into it.
+type Bundle struct {
+	Ref     string
+	Digest  string
+	Objects []Object
+}
+
+// Pull fetches ref from a registry, using the default keychain so
+// `docker login`/`gcloud auth configure-docker` credentials apply
+// automatically, and extracts every Tekton Bundle layer it contains.
+func Pull(ctx context.Context, ref string) (*Bundle, error) {
+	tag, err := name.ParseReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("parse bundle ref %s: %w", ref, err)
+	}
+
+	img, err := remote.Image(tag, remote.WithContext(ctx), remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return nil, fmt.Errorf("pull bundle %s: %w", ref, err)
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return nil, fmt.Errorf("read digest for %s: %w", ref, err)
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("read manifest for %s: %w", ref, err)
+	}
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("read layers for %s: %w", ref, err)
+	}
+	if len(layers) != len(manifest.Layers) {
+		return nil, fmt.Errorf("bundle %s: manifest describes %d layers but image has %d", ref, len(manifest.Layers), len(layers))
+	}
+	if len(layers) > MaxObjects {
+		return nil, fmt.Errorf("bundle %s: %d objects exceeds the %d object limit", ref, len(layers), MaxObjects)
+	}
+
+	b := &Bundle{Ref: ref, Digest: digest.String()}
+	seen := map[string]bool{}
+	for i, desc := range manifest.Layers {
+		obj, err := extractLayer(layers[i], desc)
+		if err != nil {
+			return nil, fmt.Errorf("bundle %s, layer %d: %w", ref, i, err)
+		}
+		key := obj.Kind + "/" + obj.Name
+		if seen[key] {
+			return nil, fmt.Errorf("bundle %s: duplicate kind/name %q", ref, key)
+		}
+		seen[key] = true
+		b.Objects = append(b.Objects, *obj)
+	}
+	return b, nil
+}
+
+func extractLayer(layer v1.Layer, desc v1.Descriptor) (*Object, error) {
+	if string(desc.MediaType) != MediaType {
+		return nil, fmt.Errorf("unexpected media type %s, want %s", desc.MediaType, MediaType)
+	}
+	kind := desc.Annotations[KindAnnotation]
+	apiVersion := desc.Annotations[APIVersionAnnotation]
+	if kind == "" || apiVersion == "" {
+		return nil, fmt.Errorf("missing required annotation %s/%s", KindAnnotation, APIVersionAnnotation)
+	}
+
+	rc, err := layer.Uncompressed()
+	if err != nil {
+		return nil, fmt.Errorf("open layer: %w", err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("read layer: %w", err)
+	}
+
+	var meta struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	if err := yaml.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("read metadata.name: %w", err)
+	}
+
+	return &Object{Kind: kind, APIVersion: apiVersion, Name: meta.Metadata.Name, YAML: data}, nil
+}
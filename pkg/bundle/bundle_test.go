@@ -0,0 +1,102 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+// testObject is one layer to pack into a synthetic bundle pushed to an
+// in-process registry.
+type testObject struct {
+	kind string
+	name string
+}
+
+// pushBundle builds and pushes an image with one layer per obj, tagged
+// "latest" on an in-process registry, and returns the ref Pull should use.
+func pushBundle(t *testing.T, objs []testObject) string {
+	t.Helper()
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+
+	img := empty.Image
+	for _, obj := range objs {
+		data := []byte(fmt.Sprintf("kind: %s\nmetadata:\n  name: %s\n", obj.kind, obj.name))
+		img2, err := mutate.Append(img, mutate.Addendum{
+			Layer:     static.NewLayer(data, types.MediaType(MediaType)),
+			MediaType: types.MediaType(MediaType),
+			Annotations: map[string]string{
+				KindAnnotation:       obj.kind,
+				APIVersionAnnotation: "tekton.dev/v1",
+			},
+		})
+		if err != nil {
+			t.Fatalf("append layer: %v", err)
+		}
+		img = img2
+	}
+
+	repo := strings.TrimPrefix(srv.URL, "http://") + "/test/bundle:latest"
+	ref, err := name.ParseReference(repo)
+	if err != nil {
+		t.Fatalf("parse ref %s: %v", repo, err)
+	}
+	if err := remote.Write(ref, img); err != nil {
+		t.Fatalf("push bundle: %v", err)
+	}
+	return ref.String()
+}
+
+func TestPull(t *testing.T) {
+	ref := pushBundle(t, []testObject{{kind: "Task", name: "build"}, {kind: "Pipeline", name: "ci"}})
+
+	b, err := Pull(context.Background(), ref)
+	if err != nil {
+		t.Fatalf("Pull: %v", err)
+	}
+	if len(b.Objects) != 2 {
+		t.Fatalf("got %d objects, want 2", len(b.Objects))
+	}
+	if b.Objects[0].Kind != "Task" || b.Objects[0].Name != "build" {
+		t.Errorf("object 0 = %+v, want Task/build", b.Objects[0])
+	}
+	if b.Objects[1].Kind != "Pipeline" || b.Objects[1].Name != "ci" {
+		t.Errorf("object 1 = %+v, want Pipeline/ci", b.Objects[1])
+	}
+	if b.Digest == "" {
+		t.Error("Digest is empty")
+	}
+}
+
+func TestPull_MaxObjectsExceeded(t *testing.T) {
+	var objs []testObject
+	for i := 0; i <= MaxObjects; i++ {
+		objs = append(objs, testObject{kind: "Task", name: fmt.Sprintf("task-%d", i)})
+	}
+	ref := pushBundle(t, objs)
+
+	_, err := Pull(context.Background(), ref)
+	if err == nil || !strings.Contains(err.Error(), "object limit") {
+		t.Fatalf("Pull() err = %v, want an object limit error", err)
+	}
+}
+
+func TestPull_DuplicateKindName(t *testing.T) {
+	ref := pushBundle(t, []testObject{{kind: "Task", name: "build"}, {kind: "Task", name: "build"}})
+
+	_, err := Pull(context.Background(), ref)
+	if err == nil || !strings.Contains(err.Error(), "duplicate kind/name") {
+		t.Fatalf("Pull() err = %v, want a duplicate kind/name error", err)
+	}
+}
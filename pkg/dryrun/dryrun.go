@@ -0,0 +1,147 @@
+// Package dryrun validates Tekton resources the same way the cluster would:
+// by issuing a dry-run Create against a live API server so that admission
+// webhooks, including Tekton's own validating webhook, actually run.
+package dryrun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	pipelineV1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	versioned "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/redhat-ai-tools/tekton-genie/pkg/validate"
+)
+
+// Client issues dry-run Creates against a Tekton-enabled cluster.
+type Client struct {
+	tekton versioned.Interface
+}
+
+// NewClient builds a Client from a kubeconfig path and context name. An
+// empty context uses the kubeconfig's current context.
+func NewClient(kubeconfig, kubeContext string) (*Client, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = kubeconfig
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		rules,
+		&clientcmd.ConfigOverrides{CurrentContext: kubeContext},
+	).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("load kubeconfig: %w", err)
+	}
+
+	tekton, err := versioned.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("build Tekton clientset: %w", err)
+	}
+	return &Client{tekton: tekton}, nil
+}
+
+// FieldError is one field-level cause the API server reported, addressed by
+// JSONPath the way apierrors.StatusError.ErrStatus.Details.Causes are.
+type FieldError struct {
+	JSONPath string
+	Message  string
+}
+
+// Outcome is the result of dry-run-creating a single document.
+type Outcome struct {
+	Kind      string
+	Name      string
+	Namespace string
+	// Retryable is true when the server reported a transient failure
+	// (timeout, conflict, rate limit) rather than a permanent rejection.
+	Retryable   bool
+	FieldErrors []FieldError
+	Err         error
+}
+
+// OK reports whether the dry-run Create succeeded (or the object already
+// exists, which is treated as success).
+func (o Outcome) OK() bool {
+	return o.Err == nil
+}
+
+// Create issues a dry-run Create for doc against the configured cluster. A
+// document without a Name gets one synthesized from GenerateName plus a
+// UUID suffix, mirroring the in-tree ensureName helper but unique enough to
+// survive a real round trip to the API server.
+func (c *Client) Create(ctx context.Context, doc validate.Document, namespace string) *Outcome {
+	outcome := &Outcome{Kind: doc.GVK.Kind, Namespace: namespace}
+
+	ensureUniqueName(doc.Object)
+	outcome.Name = doc.Name()
+
+	opts := metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}
+	_, err := c.create(ctx, doc, namespace, opts)
+	if err == nil {
+		return outcome
+	}
+	if apierrors.IsAlreadyExists(err) {
+		return outcome
+	}
+
+	outcome.Err = err
+	outcome.Retryable = isRetryable(err)
+	outcome.FieldErrors = fieldErrors(err)
+	return outcome
+}
+
+func (c *Client) create(ctx context.Context, doc validate.Document, namespace string, opts metav1.CreateOptions) (runtime.Object, error) {
+	v1 := c.tekton.TektonV1()
+	switch typed := doc.Object.(type) {
+	case *pipelineV1.Pipeline:
+		return v1.Pipelines(namespace).Create(ctx, typed, opts)
+	case *pipelineV1.PipelineRun:
+		return v1.PipelineRuns(namespace).Create(ctx, typed, opts)
+	case *pipelineV1.Task:
+		return v1.Tasks(namespace).Create(ctx, typed, opts)
+	case *pipelineV1.TaskRun:
+		return v1.TaskRuns(namespace).Create(ctx, typed, opts)
+	default:
+		return nil, fmt.Errorf("dry-run create not supported for Kind %s", doc.GVK.Kind)
+	}
+}
+
+// isRetryable reports whether err looks like a transient server-side
+// failure rather than a permanent admission rejection.
+func isRetryable(err error) bool {
+	return apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsConflict(err)
+}
+
+// fieldErrors extracts per-field causes from a StatusError, keyed by the
+// JSONPath the API server reported them against.
+func fieldErrors(err error) []FieldError {
+	status, ok := err.(*apierrors.StatusError)
+	if !ok || status.ErrStatus.Details == nil {
+		return nil
+	}
+	fes := make([]FieldError, 0, len(status.ErrStatus.Details.Causes))
+	for _, cause := range status.ErrStatus.Details.Causes {
+		fes = append(fes, FieldError{JSONPath: cause.Field, Message: cause.Message})
+	}
+	return fes
+}
+
+// ensureUniqueName fills in Name from GenerateName plus a UUID suffix when a
+// resource was authored without one, so a dry-run Create against a real API
+// server doesn't collide with anything already in the namespace.
+func ensureUniqueName(obj runtime.Object) {
+	meta, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	if meta.GetName() == "" && meta.GetGenerateName() != "" {
+		meta.SetName(meta.GetGenerateName() + uuid.NewString())
+	}
+}
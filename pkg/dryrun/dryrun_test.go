@@ -0,0 +1,100 @@
+package dryrun
+
+import (
+	"context"
+	"testing"
+
+	pipelineV1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	fake "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/fake"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/redhat-ai-tools/tekton-genie/pkg/validate"
+)
+
+func taskDocument(name string) validate.Document {
+	return validate.Document{
+		GVK: schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "Task"},
+		Object: &pipelineV1.Task{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		},
+	}
+}
+
+func TestClient_Create_Succeeds(t *testing.T) {
+	c := &Client{tekton: fake.NewSimpleClientset()}
+
+	outcome := c.Create(context.Background(), taskDocument("build"), "default")
+	if !outcome.OK() {
+		t.Fatalf("Create() = %+v, want OK", outcome)
+	}
+	if outcome.Kind != "Task" || outcome.Name != "build" || outcome.Namespace != "default" {
+		t.Errorf("Create() = %+v, want Kind/Name/Namespace populated", outcome)
+	}
+}
+
+func TestClient_Create_AlreadyExistsIsOK(t *testing.T) {
+	existing := &pipelineV1.Task{ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "default"}}
+	c := &Client{tekton: fake.NewSimpleClientset(existing)}
+
+	outcome := c.Create(context.Background(), taskDocument("build"), "default")
+	if !outcome.OK() {
+		t.Fatalf("Create() for an already-existing object = %+v, want OK", outcome)
+	}
+}
+
+func TestClient_Create_UnsupportedKind(t *testing.T) {
+	c := &Client{tekton: fake.NewSimpleClientset()}
+
+	doc := validate.Document{GVK: schema.GroupVersionKind{Kind: "ClusterTask"}}
+	outcome := c.Create(context.Background(), doc, "default")
+	if outcome.OK() {
+		t.Fatal("Create() for an unsupported Kind = OK, want an error")
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"conflict", apierrors.NewConflict(schema.GroupResource{Resource: "tasks"}, "build", nil), true},
+		{"timeout", apierrors.NewTimeoutError("slow", 5), true},
+		{"invalid", apierrors.NewInvalid(schema.GroupKind{Kind: "Task"}, "build", nil), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldErrors(t *testing.T) {
+	errs := field.ErrorList{
+		field.Required(field.NewPath("spec", "steps"), "at least one step is required"),
+		field.Invalid(field.NewPath("spec", "params").Index(0).Child("type"), "bad", "must be string or array"),
+	}
+	err := apierrors.NewInvalid(schema.GroupKind{Kind: "Task"}, "build", errs)
+
+	fes := fieldErrors(err)
+	if len(fes) != 2 {
+		t.Fatalf("got %d field errors, want 2: %+v", len(fes), fes)
+	}
+	if fes[0].JSONPath != "spec.steps" {
+		t.Errorf("fes[0].JSONPath = %q, want spec.steps", fes[0].JSONPath)
+	}
+	if fes[1].JSONPath != "spec.params[0].type" {
+		t.Errorf("fes[1].JSONPath = %q, want spec.params[0].type", fes[1].JSONPath)
+	}
+}
+
+func TestFieldErrors_NonStatusError(t *testing.T) {
+	if fes := fieldErrors(context.DeadlineExceeded); fes != nil {
+		t.Errorf("fieldErrors(non-StatusError) = %v, want nil", fes)
+	}
+}
@@ -0,0 +1,146 @@
+// Package output renders tekton-genie's validation results as structured
+// JSON or SARIF so CI systems can consume them instead of scraping stdout.
+package output
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+// ConfigSource mirrors Tekton's Provenance.ConfigSource shape, identifying
+// where a validated document's bytes came from. For a local file this is
+// the file path and a sha256 of its content; when --resolve fetched the
+// document remotely, callers populate it from the git commit SHA, OCI
+// image digest, or HTTP ETag instead.
+type ConfigSource struct {
+	URI        string            `json:"uri"`
+	Digest     map[string]string `json:"digest,omitempty"`
+	EntryPoint string            `json:"entryPoint,omitempty"`
+}
+
+// FileConfigSource builds a ConfigSource for a document read from a local
+// file, digesting its content the way Tekton digests a resolved file.
+func FileConfigSource(path string, data []byte) ConfigSource {
+	sum := sha256.Sum256(data)
+	return ConfigSource{URI: "file://" + path, Digest: map[string]string{"sha256": hex.EncodeToString(sum[:])}}
+}
+
+// GitConfigSource builds a ConfigSource for a document fetched via the git
+// resolver.
+func GitConfigSource(repoURL, commitSHA, pathInRepo string) ConfigSource {
+	return ConfigSource{URI: repoURL, Digest: map[string]string{"sha1": commitSHA}, EntryPoint: pathInRepo}
+}
+
+// OCIConfigSource builds a ConfigSource for a document fetched via the
+// bundles resolver or `tekton-genie bundle`.
+func OCIConfigSource(imageRef, digest string) ConfigSource {
+	return ConfigSource{URI: imageRef, Digest: map[string]string{"sha256": strings.TrimPrefix(digest, "sha256:")}}
+}
+
+// HTTPConfigSource builds a ConfigSource for a document fetched via the
+// http or hub resolver.
+func HTTPConfigSource(url, etag string) ConfigSource {
+	cs := ConfigSource{URI: url}
+	if etag != "" {
+		cs.Digest = map[string]string{"sha1": etag}
+	}
+	return cs
+}
+
+// ResolverConfigSource builds a ConfigSource from a resolve.Source's
+// resolver name, URI and revision: a git commit is recorded under sha1 and
+// a bundle digest under sha256; any other resolver's revision (an HTTP
+// ETag, a hub version, a cluster resourceVersion) is recorded under sha1 as
+// given.
+func ResolverConfigSource(resolver, uri, revision string) ConfigSource {
+	switch resolver {
+	case "git":
+		return GitConfigSource(uri, revision, "")
+	case "bundles":
+		return OCIConfigSource(uri, revision)
+	default:
+		return HTTPConfigSource(uri, revision)
+	}
+}
+
+// FieldIssue is one field-level cause broken out of a Validate(ctx)
+// apis.FieldError, addressed by the JSONPath it names, so --output=sarif
+// can point at the precise field that failed instead of just the document.
+type FieldIssue struct {
+	Path    string `json:"path,omitempty"`
+	Message string `json:"message"`
+}
+
+// FieldIssues breaks err down into one FieldIssue per path an
+// apis.FieldError names. Errors that aren't an apis.FieldError (decode
+// failures, dry-run server errors) yield nil, and callers fall back to the
+// flattened Error string.
+func FieldIssues(err error) []FieldIssue {
+	var fe *apis.FieldError
+	if !errors.As(err, &fe) {
+		return nil
+	}
+	var issues []FieldIssue
+	for _, wrapped := range fe.WrappedErrors() {
+		if len(wrapped.Paths) == 0 {
+			issues = append(issues, FieldIssue{Message: wrapped.Message})
+			continue
+		}
+		for _, path := range wrapped.Paths {
+			issues = append(issues, FieldIssue{Path: path, Message: wrapped.Message})
+		}
+	}
+	return issues
+}
+
+// DocumentReport is one validated document's result for --output=json.
+type DocumentReport struct {
+	Kind         string       `json:"kind"`
+	Name         string       `json:"name"`
+	File         string       `json:"file"`
+	Line         int          `json:"line,omitempty"`
+	Status       string       `json:"status"`
+	Error        string       `json:"error,omitempty"`
+	FieldIssues  []FieldIssue `json:"fieldIssues,omitempty"`
+	ConfigSource ConfigSource `json:"configSource"`
+}
+
+// Report is the full --output=json payload: one entry per validated
+// document, in the order they were encountered.
+type Report struct {
+	Documents []DocumentReport `json:"documents"`
+}
+
+// OK reports whether every document in the report passed.
+func (r *Report) OK() bool {
+	for _, d := range r.Documents {
+		if d.Status != "PASS" {
+			return false
+		}
+	}
+	return true
+}
+
+// Add appends one document's outcome to the report.
+func (r *Report) Add(kind, name, file string, line int, err error, source ConfigSource) {
+	d := DocumentReport{Kind: kind, Name: name, File: file, Line: line, Status: "PASS", ConfigSource: source}
+	if err != nil {
+		d.Status = "FAIL"
+		d.Error = err.Error()
+		d.FieldIssues = FieldIssues(err)
+	}
+	r.Documents = append(r.Documents, d)
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
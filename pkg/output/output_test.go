@@ -0,0 +1,104 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"knative.dev/pkg/apis"
+)
+
+func TestReportAdd_OK(t *testing.T) {
+	var r Report
+	r.Add("Task", "build", "task.yaml", 1, nil, ConfigSource{URI: "file://task.yaml"})
+
+	if !r.OK() {
+		t.Fatal("OK() = false, want true")
+	}
+	if r.Documents[0].Status != "PASS" {
+		t.Errorf("Status = %q, want PASS", r.Documents[0].Status)
+	}
+}
+
+func TestReportAdd_FieldError(t *testing.T) {
+	fe := apis.ErrMissingField("spec.steps").Also(apis.ErrInvalidValue("bad", "spec.params[0].type"))
+
+	var r Report
+	r.Add("Task", "build", "task.yaml", 3, fe, ConfigSource{URI: "file://task.yaml"})
+
+	if r.OK() {
+		t.Fatal("OK() = true, want false")
+	}
+	d := r.Documents[0]
+	if d.Status != "FAIL" {
+		t.Fatalf("Status = %q, want FAIL", d.Status)
+	}
+	if len(d.FieldIssues) != 2 {
+		t.Fatalf("got %d FieldIssues, want 2: %+v", len(d.FieldIssues), d.FieldIssues)
+	}
+	var paths []string
+	for _, issue := range d.FieldIssues {
+		paths = append(paths, issue.Path)
+	}
+	if !contains(paths, "spec.steps") || !contains(paths, "spec.params[0].type") {
+		t.Errorf("FieldIssues paths = %v, want spec.steps and spec.params[0].type", paths)
+	}
+}
+
+func TestReportAdd_NonFieldError(t *testing.T) {
+	var r Report
+	r.Add("Task", "build", "task.yaml", 1, fmt.Errorf("decode: unexpected EOF"), ConfigSource{})
+
+	d := r.Documents[0]
+	if d.Error != "decode: unexpected EOF" {
+		t.Errorf("Error = %q", d.Error)
+	}
+	if len(d.FieldIssues) != 0 {
+		t.Errorf("FieldIssues = %v, want none for a non-FieldError", d.FieldIssues)
+	}
+}
+
+func TestWriteSARIF_OneResultPerFieldIssue(t *testing.T) {
+	var r Report
+	r.Add("Task", "build", "task.yaml", 5, apis.ErrMissingField("spec.steps"), ConfigSource{})
+	r.Add("Task", "ok", "task.yaml", 1, nil, ConfigSource{})
+
+	var buf bytes.Buffer
+	if err := r.WriteSARIF(&buf); err != nil {
+		t.Fatalf("WriteSARIF: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, `"spec.steps: missing field(s)"`) {
+		t.Errorf("SARIF output missing the field path in its message:\n%s", out)
+	}
+	if !strings.Contains(out, `"startLine": 5`) {
+		t.Errorf("SARIF output missing the document's line number:\n%s", out)
+	}
+	if strings.Count(out, `"ruleId"`) != 1 {
+		t.Errorf("want exactly one result for the single failing document, got:\n%s", out)
+	}
+}
+
+func TestWriteSARIF_FallsBackToFlattenedError(t *testing.T) {
+	var r Report
+	r.Add("Task", "bad", "task.yaml", 2, fmt.Errorf("decode: unexpected EOF"), ConfigSource{})
+
+	var buf bytes.Buffer
+	if err := r.WriteSARIF(&buf); err != nil {
+		t.Fatalf("WriteSARIF: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"decode: unexpected EOF"`) {
+		t.Errorf("SARIF output missing the flattened error:\n%s", buf.String())
+	}
+}
+
+func contains(haystack []string, want string) bool {
+	for _, s := range haystack {
+		if s == want {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// sarifVersion and sarifSchema pin the SARIF log to the version GitHub code
+// scanning expects.
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string `json:"id"`
+	ShortDescription struct {
+		Text string `json:"text"`
+	} `json:"shortDescription"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+const ruleValidationFailure = "tekton-genie/validation-failure"
+
+// WriteSARIF renders the report's failures as a SARIF 2.1.0 log, with one
+// result per FieldIssue an apis.FieldError named (falling back to one
+// result for the whole document when no field-level data is available, as
+// for decode failures), so GitHub code scanning can surface Pipeline lint
+// failures inline on PRs.
+func (r *Report) WriteSARIF(w io.Writer) error {
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name: "tekton-genie",
+				Rules: []sarifRule{{
+					ID: ruleValidationFailure,
+					ShortDescription: struct {
+						Text string `json:"text"`
+					}{Text: "Tekton resource failed Validate(ctx)"},
+				}},
+			}},
+		}},
+	}
+
+	for _, d := range r.Documents {
+		if d.Status != "FAIL" {
+			continue
+		}
+		line := d.Line
+		if line < 1 {
+			line = 1
+		}
+
+		issues := d.FieldIssues
+		if len(issues) == 0 {
+			issues = []FieldIssue{{Message: d.Error}}
+		}
+		for _, issue := range issues {
+			text := issue.Message
+			if issue.Path != "" {
+				text = fmt.Sprintf("%s: %s", issue.Path, issue.Message)
+			}
+			log.Runs[0].Results = append(log.Runs[0].Results, sarifResult{
+				RuleID:  ruleValidationFailure,
+				Level:   "error",
+				Message: sarifMessage{Text: text},
+				Locations: []sarifLocation{{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: d.File},
+						Region:           sarifRegion{StartLine: line},
+					},
+				}},
+			})
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
@@ -0,0 +1,34 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-ai-tools/tekton-genie/pkg/bundle"
+)
+
+// BundlesFetcher pulls an OCI image and extracts one object's YAML out of
+// it, for `pipelineRef.resolver: bundles` / `taskRef.resolver: bundles`.
+type BundlesFetcher struct{}
+
+// Fetch pulls req.Params["bundle"] and returns the resource named
+// req.Params["name"] / req.Params["kind"] from within it.
+func (BundlesFetcher) Fetch(ctx context.Context, req Request) ([]byte, Source, error) {
+	ref := req.Params["bundle"]
+	if ref == "" {
+		return nil, Source{}, fmt.Errorf("bundles resolver requires a bundle param")
+	}
+
+	b, err := bundle.Pull(ctx, ref)
+	if err != nil {
+		return nil, Source{}, err
+	}
+
+	kind, resName := req.Params["kind"], req.Params["name"]
+	for _, obj := range b.Objects {
+		if (kind == "" || obj.Kind == kind) && (resName == "" || obj.Name == resName) {
+			return obj.YAML, Source{Resolver: "bundles", URI: ref, Revision: b.Digest}, nil
+		}
+	}
+	return nil, Source{}, fmt.Errorf("bundle %s has no %s/%s object", ref, kind, resName)
+}
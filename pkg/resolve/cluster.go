@@ -0,0 +1,55 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+
+	versioned "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// ClusterFetcher reads an already-applied resource back out of the cluster,
+// for `pipelineRef.resolver: cluster` / `taskRef.resolver: cluster`.
+type ClusterFetcher struct {
+	Tekton versioned.Interface
+}
+
+// Fetch looks up req.Params["kind"]/req.Params["name"] in
+// req.Params["namespace"].
+func (f ClusterFetcher) Fetch(ctx context.Context, req Request) ([]byte, Source, error) {
+	if f.Tekton == nil {
+		return nil, Source{}, fmt.Errorf("cluster resolver requires a Tekton clientset")
+	}
+
+	kind := req.Params["kind"]
+	resName := req.Params["name"]
+	namespace := req.Params["namespace"]
+	if kind == "" || resName == "" {
+		return nil, Source{}, fmt.Errorf("cluster resolver requires kind and name params")
+	}
+
+	v1 := f.Tekton.TektonV1()
+	var (
+		obj interface{ GetResourceVersion() string }
+		err error
+	)
+	switch kind {
+	case "Pipeline":
+		obj, err = v1.Pipelines(namespace).Get(ctx, resName, metav1.GetOptions{})
+	case "Task":
+		obj, err = v1.Tasks(namespace).Get(ctx, resName, metav1.GetOptions{})
+	default:
+		return nil, Source{}, fmt.Errorf("cluster resolver does not support Kind %s", kind)
+	}
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("get %s %s/%s: %w", kind, namespace, resName, err)
+	}
+
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("marshal %s %s/%s: %w", kind, namespace, resName, err)
+	}
+
+	return data, Source{Resolver: "cluster", URI: fmt.Sprintf("%s/%s/%s", kind, namespace, resName), Revision: obj.GetResourceVersion()}, nil
+}
@@ -0,0 +1,71 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// GitFetcher clones a repository at the requested revision and reads a file
+// out of it, for `pipelineRef.resolver: git` / `taskRef.resolver: git`.
+type GitFetcher struct{}
+
+// Fetch clones req.Params["url"] at req.Params["revision"] into a temp
+// directory and reads req.Params["pathInRepo"] out of the checkout.
+func (GitFetcher) Fetch(ctx context.Context, req Request) ([]byte, Source, error) {
+	url := req.Params["url"]
+	revision := req.Params["revision"]
+	path := req.Params["pathInRepo"]
+	if url == "" || path == "" {
+		return nil, Source{}, fmt.Errorf("git resolver requires url and pathInRepo params")
+	}
+
+	dir, err := os.MkdirTemp("", "tekton-genie-git-*")
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("create temp checkout dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	repo, err := git.PlainCloneContext(ctx, dir, false, &git.CloneOptions{
+		URL: url,
+	})
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("clone %s: %w", url, err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("open worktree for %s: %w", url, err)
+	}
+	if revision != "" {
+		if err := worktree.Checkout(&git.CheckoutOptions{
+			Hash: plumbing.NewHash(revision),
+		}); err != nil {
+			// PlainCloneContext only creates a local branch ref for the
+			// default branch; every other branch is fetched as a
+			// remote-tracking ref, so that's what a non-default revision
+			// must resolve against.
+			if err := worktree.Checkout(&git.CheckoutOptions{
+				Branch: plumbing.NewRemoteReferenceName("origin", revision),
+			}); err != nil {
+				return nil, Source{}, fmt.Errorf("checkout %s@%s: %w", url, revision, err)
+			}
+		}
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("resolve HEAD for %s: %w", url, err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, path))
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("read %s from %s@%s: %w", path, url, revision, err)
+	}
+
+	return data, Source{Resolver: "git", URI: url, Revision: head.Hash().String()}, nil
+}
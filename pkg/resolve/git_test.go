@@ -0,0 +1,100 @@
+package resolve
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// newTestRepo creates a local repo on disk with a "master" default branch and a
+// "feature" branch whose file content differs, so a test can tell which one
+// a checkout actually landed on.
+func newTestRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("PlainInit: %v", err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatalf("Worktree: %v", err)
+	}
+
+	commit := func(content string) {
+		if err := os.WriteFile(filepath.Join(dir, "task.yaml"), []byte(content), 0o644); err != nil {
+			t.Fatalf("write task.yaml: %v", err)
+		}
+		if _, err := worktree.Add("task.yaml"); err != nil {
+			t.Fatalf("add: %v", err)
+		}
+		_, err := worktree.Commit("update task.yaml", &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Unix(0, 0)},
+		})
+		if err != nil {
+			t.Fatalf("commit: %v", err)
+		}
+	}
+
+	commit("on-main\n")
+
+	head, err := repo.Head()
+	if err != nil {
+		t.Fatalf("Head: %v", err)
+	}
+	featureRef := plumbing.NewHashReference(plumbing.NewBranchReferenceName("feature"), head.Hash())
+	if err := repo.Storer.SetReference(featureRef); err != nil {
+		t.Fatalf("create feature branch: %v", err)
+	}
+	if err := worktree.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("feature")}); err != nil {
+		t.Fatalf("checkout feature: %v", err)
+	}
+	commit("on-feature\n")
+
+	if err := worktree.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("master")}); err != nil {
+		t.Fatalf("checkout master: %v", err)
+	}
+
+	return dir
+}
+
+func TestGitFetcher_Fetch_NonDefaultBranch(t *testing.T) {
+	repoDir := newTestRepo(t)
+
+	data, src, err := GitFetcher{}.Fetch(context.Background(), Request{Params: map[string]string{
+		"url":        repoDir,
+		"revision":   "feature",
+		"pathInRepo": "task.yaml",
+	}})
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if string(data) != "on-feature\n" {
+		t.Errorf("data = %q, want on-feature content", data)
+	}
+	if src.Revision == "" {
+		t.Errorf("Source.Revision is empty, want the resolved commit hash")
+	}
+}
+
+func TestGitFetcher_Fetch_DefaultBranch(t *testing.T) {
+	repoDir := newTestRepo(t)
+
+	data, _, err := GitFetcher{}.Fetch(context.Background(), Request{Params: map[string]string{
+		"url":        repoDir,
+		"pathInRepo": "task.yaml",
+	}})
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if string(data) != "on-main\n" {
+		t.Errorf("data = %q, want on-main content", data)
+	}
+}
@@ -0,0 +1,48 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// HTTPFetcher GETs a URL, for `pipelineRef.resolver: http` /
+// `taskRef.resolver: http`.
+type HTTPFetcher struct {
+	Client *http.Client
+}
+
+// Fetch issues a GET for req.Params["url"].
+func (f HTTPFetcher) Fetch(ctx context.Context, req Request) ([]byte, Source, error) {
+	url := req.Params["url"]
+	if url == "" {
+		return nil, Source{}, fmt.Errorf("http resolver requires a url param")
+	}
+
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("build request for %s: %w", url, err)
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("GET %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, Source{}, fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("read response body from %s: %w", url, err)
+	}
+
+	return data, Source{Resolver: "http", URI: url, Revision: resp.Header.Get("ETag")}, nil
+}
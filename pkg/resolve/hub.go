@@ -0,0 +1,76 @@
+package resolve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// defaultHubAPI is the Artifact Hub Tekton endpoint every hub request is
+// sent to; the catalog param only selects the catalog path under it.
+const defaultHubAPI = "https://artifacthub.io/api/v1/tekton"
+
+// HubFetcher queries the Artifact Hub API, for `pipelineRef.resolver: hub` /
+// `taskRef.resolver: hub`.
+type HubFetcher struct {
+	Client *http.Client
+}
+
+type hubResource struct {
+	Data struct {
+		YAML string `json:"manifestRaw"`
+	} `json:"data"`
+	Version string `json:"version"`
+}
+
+// Fetch looks up req.Params["kind"]/req.Params["name"] at
+// req.Params["version"] (or latest) in the Artifact Hub catalog.
+func (f HubFetcher) Fetch(ctx context.Context, req Request) ([]byte, Source, error) {
+	kind := req.Params["kind"]
+	resName := req.Params["name"]
+	if kind == "" || resName == "" {
+		return nil, Source{}, fmt.Errorf("hub resolver requires kind and name params")
+	}
+
+	catalog := req.Params["catalog"]
+	if catalog == "" {
+		catalog = "tekton-catalog-tasks"
+	}
+	url := fmt.Sprintf("%s/%s/%s/%s", defaultHubAPI, kind, catalog, resName)
+	if v := req.Params["version"]; v != "" {
+		url += "/" + v
+	}
+
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("build request for %s: %w", url, err)
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("query hub %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, Source{}, fmt.Errorf("query hub %s: unexpected status %s", url, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, Source{}, fmt.Errorf("read hub response from %s: %w", url, err)
+	}
+
+	var res hubResource
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, Source{}, fmt.Errorf("decode hub response from %s: %w", url, err)
+	}
+
+	return []byte(res.Data.YAML), Source{Resolver: "hub", URI: url, Revision: res.Version}, nil
+}
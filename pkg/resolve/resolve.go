@@ -0,0 +1,107 @@
+// Package resolve fetches the remote Pipeline or Task a PipelineRun/TaskRun
+// refers to through pipelineRef.resolver/taskRef.resolver, so tekton-genie
+// can validate the referenced resource instead of just the run envelope.
+package resolve
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+// Request is the resolver name plus params a PipelineRun or TaskRun supplied
+// in its ResolverRef, normalized into a map for convenience.
+type Request struct {
+	Resolver string
+	Params   map[string]string
+}
+
+// NewRequest converts a Tekton ResolverRef's Param list into a Request.
+func NewRequest(ref v1.ResolverRef) Request {
+	params := make(map[string]string, len(ref.Params))
+	for _, p := range ref.Params {
+		params[p.Name] = p.Value.StringVal
+	}
+	return Request{Resolver: string(ref.Resolver), Params: params}
+}
+
+// Source identifies where a resolved document came from, for provenance and
+// for error messages.
+type Source struct {
+	Resolver string
+	URI      string
+	Revision string
+}
+
+func (s Source) String() string {
+	if s.Revision != "" {
+		return fmt.Sprintf("%s resolver (%s@%s)", s.Resolver, s.URI, s.Revision)
+	}
+	return fmt.Sprintf("%s resolver (%s)", s.Resolver, s.URI)
+}
+
+// Fetcher fetches the raw YAML a Request points at.
+type Fetcher interface {
+	Fetch(ctx context.Context, req Request) ([]byte, Source, error)
+}
+
+// Registry dispatches a Request to the Fetcher registered for its resolver
+// name (git, bundles, http, hub, cluster).
+type Registry struct {
+	fetchers map[string]Fetcher
+}
+
+// NewRegistry builds a Registry with the standard set of resolvers. Any
+// resolver may be nil, in which case requests for it fail with a clear
+// error instead of a nil-pointer panic; this lets callers opt out of
+// resolvers that need credentials or cluster access they don't have.
+func NewRegistry(git, bundles, http, hub, cluster Fetcher) *Registry {
+	r := &Registry{fetchers: map[string]Fetcher{}}
+	for name, f := range map[string]Fetcher{
+		"git":     git,
+		"bundles": bundles,
+		"http":    http,
+		"hub":     hub,
+		"cluster": cluster,
+	} {
+		if f != nil {
+			r.fetchers[name] = f
+		}
+	}
+	return r
+}
+
+// Resolve fetches the document req points at.
+func (r *Registry) Resolve(ctx context.Context, req Request) ([]byte, Source, error) {
+	f, ok := r.fetchers[req.Resolver]
+	if !ok {
+		return nil, Source{}, fmt.Errorf("no %q resolver configured", req.Resolver)
+	}
+	return f.Fetch(ctx, req)
+}
+
+// ValidateParams checks that every required declared param (one with no
+// default) is supplied by the run, the way `$(params.foo)` substitution
+// would fail at execution time if it weren't. It does not reject extra
+// params the run supplies beyond what's declared, mirroring Tekton's own
+// leniency there.
+func ValidateParams(declared []v1.ParamSpec, provided []v1.Param) error {
+	have := make(map[string]bool, len(provided))
+	for _, p := range provided {
+		have[p.Name] = true
+	}
+	var missing []string
+	for _, d := range declared {
+		if d.Default != nil {
+			continue
+		}
+		if !have[d.Name] {
+			missing = append(missing, d.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required param(s) with no default: %v", missing)
+	}
+	return nil
+}
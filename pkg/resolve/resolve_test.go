@@ -0,0 +1,74 @@
+package resolve
+
+import (
+	"testing"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+func TestValidateParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		declared []v1.ParamSpec
+		provided []v1.Param
+		wantErr  bool
+	}{
+		{
+			name:     "no declared params",
+			declared: nil,
+			provided: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "required param supplied",
+			declared: []v1.ParamSpec{{Name: "image"}},
+			provided: []v1.Param{{Name: "image"}},
+			wantErr:  false,
+		},
+		{
+			name:     "required param missing",
+			declared: []v1.ParamSpec{{Name: "image"}},
+			provided: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "defaulted param missing is fine",
+			declared: []v1.ParamSpec{{Name: "image", Default: &v1.ParamValue{StringVal: "latest"}}},
+			provided: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "extra provided params are ignored",
+			declared: []v1.ParamSpec{{Name: "image"}},
+			provided: []v1.Param{{Name: "image"}, {Name: "extra"}},
+			wantErr:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateParams(tt.declared, tt.provided)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateParams() err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	ref := v1.ResolverRef{
+		Resolver: "git",
+		Params: []v1.Param{
+			{Name: "url", Value: v1.ParamValue{StringVal: "https://example.com/repo.git"}},
+			{Name: "revision", Value: v1.ParamValue{StringVal: "main"}},
+		},
+	}
+
+	req := NewRequest(ref)
+	if req.Resolver != "git" {
+		t.Errorf("Resolver = %q, want git", req.Resolver)
+	}
+	if req.Params["url"] != "https://example.com/repo.git" || req.Params["revision"] != "main" {
+		t.Errorf("Params = %v, want url and revision set", req.Params)
+	}
+}
@@ -0,0 +1,289 @@
+// Package validate decodes Tekton resource YAML and runs each object's
+// in-tree Validate(ctx) method, aggregating failures across an entire
+// multi-document stream instead of stopping at the first error.
+package validate
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"knative.dev/pkg/apis"
+)
+
+// validatable is implemented by every Tekton API type tekton-genie knows how
+// to check.
+type validatable interface {
+	Validate(ctx context.Context) *apis.FieldError
+}
+
+// Kinds lists every Kind tekton-genie can decode and validate, in the same
+// order the Tekton webhook registers its admission handlers. ClusterTask is
+// deliberately absent: it was removed from tektoncd/pipeline after v0.41 and
+// this pinned version registers no type for it at all, so Decode reports it
+// with clusterTaskUnsupportedMsg instead of a cryptic scheme error.
+var Kinds = []string{
+	"Pipeline",
+	"PipelineRun",
+	"Task",
+	"TaskRun",
+	"CustomRun",
+	"StepAction",
+	"VerificationPolicy",
+}
+
+// clusterTaskUnsupportedMsg explains why a ClusterTask document can't be
+// validated, rather than surfacing the scheme package's cryptic "no kind
+// ... is registered" error for a type it was never told about.
+const clusterTaskUnsupportedMsg = "ClusterTask was removed from tektoncd/pipeline after v0.41 and is not supported by this build"
+
+// NewScheme builds a runtime.Scheme with every Tekton API group tekton-genie
+// validates registered against it.
+func NewScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{
+		v1.AddToScheme,
+		v1beta1.AddToScheme,
+		v1alpha1.AddToScheme,
+	} {
+		if err := add(scheme); err != nil {
+			return nil, fmt.Errorf("register scheme: %w", err)
+		}
+	}
+	return scheme, nil
+}
+
+// Failure describes one document that failed to decode or validate.
+type Failure struct {
+	File string
+	Line int
+	Kind string
+	Name string
+	Err  error
+}
+
+func (f Failure) String() string {
+	loc := f.File
+	if f.Line > 0 {
+		loc = fmt.Sprintf("%s:%d", f.File, f.Line)
+	}
+	if f.Kind == "" {
+		return fmt.Sprintf("%s: %v", loc, f.Err)
+	}
+	return fmt.Sprintf("%s: %s %q: %v", loc, f.Kind, f.Name, f.Err)
+}
+
+// Outcome records one document's validation result, success or failure,
+// so callers that need full coverage (e.g. JSON/SARIF output) don't have
+// to re-decode and re-validate just to learn about the documents that
+// passed.
+type Outcome struct {
+	Document Document
+	Err      error
+}
+
+// OK reports whether the document passed decoding and validation.
+func (o Outcome) OK() bool {
+	return o.Err == nil
+}
+
+// Result is the outcome of validating every document in a stream.
+type Result struct {
+	Checked  int
+	Failures []Failure
+	Outcomes []Outcome
+}
+
+// OK reports whether every document passed decoding and validation.
+func (r *Result) OK() bool {
+	return len(r.Failures) == 0
+}
+
+// Validator decodes and validates Tekton resources, optionally restricted to
+// a subset of Kinds.
+type Validator struct {
+	scheme *runtime.Scheme
+	kinds  map[string]bool
+}
+
+// New returns a Validator that checks every Kind in Kinds. If kinds is
+// non-empty, only those Kinds are decoded; documents of any other Kind are
+// skipped rather than treated as failures.
+func New(kinds []string) (*Validator, error) {
+	scheme, err := NewScheme()
+	if err != nil {
+		return nil, err
+	}
+	v := &Validator{scheme: scheme}
+	if len(kinds) > 0 {
+		v.kinds = make(map[string]bool, len(kinds))
+		for _, k := range kinds {
+			v.kinds[k] = true
+		}
+	}
+	return v, nil
+}
+
+func (v *Validator) wants(kind string) bool {
+	if v.kinds == nil {
+		return true
+	}
+	return v.kinds[kind]
+}
+
+// Document is one decoded resource from a multi-document YAML stream.
+type Document struct {
+	File   string
+	Line   int
+	GVK    schema.GroupVersionKind
+	Object runtime.Object
+}
+
+// Name returns the decoded object's metadata name, or "" if it has none.
+func (d Document) Name() string {
+	return objectName(d.Object)
+}
+
+// Decode splits data into YAML documents using a YAML-aware reader (so a
+// line that is literally "---" inside a "script: |" block scalar isn't
+// mistaken for a document boundary the way a naive string split would be)
+// and decodes every document it contains, recording the 1-indexed line each
+// document starts on. Documents whose Kind isn't in the Validator's filter
+// are skipped. Decode errors are returned as Failures rather than a single
+// Decode error so the rest of the stream can still be processed.
+func (v *Validator) Decode(file string, data []byte) ([]Document, []Failure) {
+	decoder := json.NewSerializerWithOptions(
+		json.DefaultMetaFactory,
+		v.scheme,
+		v.scheme,
+		json.SerializerOptions{Yaml: true, Pretty: false, Strict: true},
+	)
+
+	var (
+		docs     []Document
+		failures []Failure
+	)
+	reader := yamlutil.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	line := 1
+	if bytes.HasPrefix(data, []byte("---")) {
+		// The reader swallows a leading separator without returning it.
+		line = 2
+	}
+	for {
+		raw, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		docLine := line
+		line += bytes.Count(raw, []byte("\n")) + 1
+		if err != nil {
+			failures = append(failures, Failure{File: file, Line: docLine, Err: fmt.Errorf("decode: %w", err)})
+			break
+		}
+
+		trimmed := strings.TrimSpace(string(raw))
+		if trimmed == "" {
+			continue
+		}
+
+		kind, peekErr := peekKind(trimmed)
+		if peekErr == nil && kind != "" && !v.wants(kind) {
+			continue
+		}
+		if peekErr == nil && kind == "ClusterTask" {
+			failures = append(failures, Failure{File: file, Line: docLine, Kind: kind, Err: fmt.Errorf("decode: %s", clusterTaskUnsupportedMsg)})
+			continue
+		}
+
+		obj, gvk, err := decoder.Decode([]byte(trimmed), nil, nil)
+		if err != nil {
+			failures = append(failures, Failure{File: file, Line: docLine, Err: fmt.Errorf("decode: %w", err)})
+			continue
+		}
+		if !v.wants(gvk.Kind) {
+			continue
+		}
+		docs = append(docs, Document{File: file, Line: docLine, GVK: *gvk, Object: obj})
+	}
+	return docs, failures
+}
+
+// ValidateStream decodes every document in data and validates it, recording
+// the 1-indexed line each document starts on so failures can be reported as
+// file:line.
+func (v *Validator) ValidateStream(file string, data []byte) *Result {
+	docs, failures := v.Decode(file, data)
+	result := &Result{Checked: len(docs) + len(failures), Failures: failures}
+	for _, doc := range docs {
+		gvk := doc.GVK
+		err := v.validateObject(&gvk, doc.Object)
+		result.Outcomes = append(result.Outcomes, Outcome{Document: doc, Err: err})
+		if err != nil {
+			result.Failures = append(result.Failures, Failure{
+				File: doc.File,
+				Line: doc.Line,
+				Kind: doc.GVK.Kind,
+				Name: doc.Name(),
+				Err:  err,
+			})
+		}
+	}
+	return result
+}
+
+func (v *Validator) validateObject(gvk *schema.GroupVersionKind, obj runtime.Object) error {
+	checkable, ok := obj.(validatable)
+	if !ok {
+		return fmt.Errorf("unsupported Kind: %s", gvk.Kind)
+	}
+	ensureName(obj)
+	if fe := checkable.Validate(context.Background()); fe != nil {
+		return fe
+	}
+	return nil
+}
+
+// ensureName fills in Name from GenerateName when a resource was authored
+// without one, the same way the API server would before admission runs.
+func ensureName(obj runtime.Object) {
+	meta, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	if meta.GetName() == "" && meta.GetGenerateName() != "" {
+		meta.SetName(meta.GetGenerateName() + "dummy")
+	}
+}
+
+// peekKind reads a document's Kind without decoding it into a concrete type,
+// so Decode can recognize a Kind the scheme has no type for (ClusterTask)
+// and report it clearly instead of letting decoder.Decode fail first.
+func peekKind(doc string) (string, error) {
+	jsonBytes, err := yamlutil.ToJSON([]byte(doc))
+	if err != nil {
+		return "", err
+	}
+	gvk, err := json.DefaultMetaFactory.Interpret(jsonBytes)
+	if err != nil {
+		return "", err
+	}
+	return gvk.Kind, nil
+}
+
+func objectName(obj runtime.Object) string {
+	if meta, ok := obj.(metav1.Object); ok {
+		return meta.GetName()
+	}
+	return ""
+}
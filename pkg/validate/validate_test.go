@@ -0,0 +1,165 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecode_MultiDocument(t *testing.T) {
+	v, err := New(nil)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	data := []byte(`apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: build
+---
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: test
+`)
+
+	docs, failures := v.Decode("tasks.yaml", data)
+	if len(failures) != 0 {
+		t.Fatalf("Decode failures = %v, want none", failures)
+	}
+	if len(docs) != 2 {
+		t.Fatalf("got %d docs, want 2", len(docs))
+	}
+	if docs[0].Name() != "build" || docs[1].Name() != "test" {
+		t.Errorf("got names %q, %q, want build, test", docs[0].Name(), docs[1].Name())
+	}
+	if docs[0].Line != 1 {
+		t.Errorf("docs[0].Line = %d, want 1", docs[0].Line)
+	}
+	if docs[1].Line != 6 {
+		t.Errorf("docs[1].Line = %d, want 6", docs[1].Line)
+	}
+}
+
+// A "---" line embedded in a script: | block scalar (e.g. a heredoc
+// separator or markdown rule) must not be mistaken for a document
+// boundary.
+func TestDecode_DashesInsideBlockScalar(t *testing.T) {
+	v, err := New(nil)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	data := []byte(`apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: build
+spec:
+  steps:
+    - name: run
+      script: |
+        cat <<EOF
+        ---
+        EOF
+---
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: test
+`)
+
+	docs, failures := v.Decode("tasks.yaml", data)
+	if len(failures) != 0 {
+		t.Fatalf("Decode failures = %v, want none", failures)
+	}
+	if len(docs) != 2 {
+		t.Fatalf("got %d docs, want 2", len(docs))
+	}
+	if docs[0].Name() != "build" || docs[1].Name() != "test" {
+		t.Errorf("got names %q, %q, want build, test", docs[0].Name(), docs[1].Name())
+	}
+}
+
+func TestDecode_KindFilter(t *testing.T) {
+	v, err := New([]string{"Pipeline"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	data := []byte(`apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: build
+---
+apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: ci
+`)
+
+	docs, failures := v.Decode("mixed.yaml", data)
+	if len(failures) != 0 {
+		t.Fatalf("Decode failures = %v, want none", failures)
+	}
+	if len(docs) != 1 || docs[0].GVK.Kind != "Pipeline" {
+		t.Fatalf("got %v, want a single Pipeline doc", docs)
+	}
+}
+
+// ClusterTask was removed from tektoncd/pipeline after v0.41, so no scheme
+// registers a type for it; Decode must report it with a clear reason instead
+// of the scheme package's "no kind ... is registered" error.
+func TestDecode_ClusterTaskUnsupported(t *testing.T) {
+	v, err := New(nil)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	data := []byte(`apiVersion: tekton.dev/v1beta1
+kind: ClusterTask
+metadata:
+  name: build
+`)
+
+	docs, failures := v.Decode("clustertask.yaml", data)
+	if len(docs) != 0 {
+		t.Fatalf("got %d docs, want 0", len(docs))
+	}
+	if len(failures) != 1 {
+		t.Fatalf("got %d failures, want 1", len(failures))
+	}
+	if failures[0].Kind != "ClusterTask" {
+		t.Errorf("failures[0].Kind = %q, want ClusterTask", failures[0].Kind)
+	}
+	if !strings.Contains(failures[0].Err.Error(), "removed from tektoncd/pipeline") {
+		t.Errorf("failures[0].Err = %v, want a message explaining ClusterTask is unsupported", failures[0].Err)
+	}
+}
+
+func TestDecode_BadDocumentReportsFailureAndLine(t *testing.T) {
+	v, err := New(nil)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	data := []byte(`apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: build
+---
+not: [valid
+`)
+
+	docs, failures := v.Decode("bad.yaml", data)
+	if len(docs) != 1 {
+		t.Fatalf("got %d docs, want 1", len(docs))
+	}
+	if len(failures) != 1 {
+		t.Fatalf("got %d failures, want 1", len(failures))
+	}
+	if failures[0].Line != 6 {
+		t.Errorf("failures[0].Line = %d, want 6", failures[0].Line)
+	}
+	if !strings.Contains(failures[0].Err.Error(), "decode:") {
+		t.Errorf("failures[0].Err = %v, want a decode error", failures[0].Err)
+	}
+}
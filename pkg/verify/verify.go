@@ -0,0 +1,252 @@
+// Package verify enforces Tekton's trusted-resources contract: a resource
+// must carry a `tekton.dev/signature` annotation that verifies against a
+// VerificationPolicy's key set before tekton-genie will pass it, the same
+// check Tekton's admission-time trusted-resources feature performs.
+package verify
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/redhat-ai-tools/tekton-genie/pkg/validate"
+)
+
+// SignatureAnnotation is the annotation trusted-resources stores a
+// resource's signature under. It's excluded from the canonical payload a
+// signature is computed over, since it can't sign itself.
+const SignatureAnnotation = "tekton.dev/signature"
+
+// NoMatchPolicy controls what happens when no VerificationPolicy matches a
+// resource's name/namespace, mirroring Tekton's
+// spec.resources[].noMatchPolicy values.
+type NoMatchPolicy string
+
+const (
+	FailNoMatch   NoMatchPolicy = "fail"
+	WarnNoMatch   NoMatchPolicy = "warn"
+	IgnoreNoMatch NoMatchPolicy = "ignore"
+)
+
+// Warning is a non-fatal verification note: the resource passed, but
+// callers should surface msg rather than discard it silently the way
+// IgnoreNoMatch does.
+type Warning struct{ msg string }
+
+func (w *Warning) Error() string { return w.msg }
+
+// LoadPolicies decodes every VerificationPolicy document in path.
+func LoadPolicies(path string) ([]*v1alpha1.VerificationPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read policy file %s: %w", path, err)
+	}
+
+	validator, err := validate.New([]string{"VerificationPolicy"})
+	if err != nil {
+		return nil, err
+	}
+	docs, failures := validator.Decode(path, data)
+	if len(failures) > 0 {
+		return nil, fmt.Errorf("decode policy file %s: %v", path, failures[0].Err)
+	}
+
+	policies := make([]*v1alpha1.VerificationPolicy, 0, len(docs))
+	for _, doc := range docs {
+		policy, ok := doc.Object.(*v1alpha1.VerificationPolicy)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected VerificationPolicy, got %s", path, doc.GVK.Kind)
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+// LoadPublicKey reads a PEM-encoded ECDSA public key from path, the format
+// `cosign generate-key-pair` produces.
+func LoadPublicKey(path string) (*ecdsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read key file %s: %w", path, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: not a PEM file", path)
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: parse public key: %w", path, err)
+	}
+	ecKey, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: only ECDSA public keys are supported", path)
+	}
+	return ecKey, nil
+}
+
+// MatchPolicy returns the first policy whose resources[].pattern matches
+// namespace/name or the bare name, the way Tekton's webhook picks a policy
+// to enforce.
+func MatchPolicy(policies []*v1alpha1.VerificationPolicy, namespace, name string) (*v1alpha1.VerificationPolicy, error) {
+	target := fmt.Sprintf("%s/%s", namespace, name)
+	for _, policy := range policies {
+		for _, res := range policy.Spec.Resources {
+			re, err := regexp.Compile(res.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("policy %s: invalid pattern %q: %w", policy.Name, res.Pattern, err)
+			}
+			if re.MatchString(target) || re.MatchString(name) {
+				return policy, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// CanonicalPayload renders obj as canonical JSON with the signature
+// annotation stripped, the payload a trusted-resources signature is
+// computed over. Go's encoding/json sorts map keys, so round-tripping
+// through map[string]interface{} is enough to canonicalize key order.
+func CanonicalPayload(obj runtime.Object) ([]byte, error) {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("marshal resource: %w", err)
+	}
+
+	var generic map[string]interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, fmt.Errorf("normalize resource: %w", err)
+	}
+	if meta, ok := generic["metadata"].(map[string]interface{}); ok {
+		if annotations, ok := meta["annotations"].(map[string]interface{}); ok {
+			delete(annotations, SignatureAnnotation)
+			if len(annotations) == 0 {
+				delete(meta, "annotations")
+			}
+		}
+	}
+
+	return json.Marshal(generic)
+}
+
+// Signature extracts and base64-decodes obj's trusted-resources signature
+// annotation.
+func Signature(obj metav1.Object) ([]byte, error) {
+	sig, ok := obj.GetAnnotations()[SignatureAnnotation]
+	if !ok || sig == "" {
+		return nil, fmt.Errorf("missing %s annotation", SignatureAnnotation)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s annotation: %w", SignatureAnnotation, err)
+	}
+	return decoded, nil
+}
+
+// Verify checks obj's trusted-resources signature against keys, succeeding
+// if any one of them verifies it.
+func Verify(ctx context.Context, obj runtime.Object, keys []*ecdsa.PublicKey) error {
+	meta, ok := obj.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("resource has no metadata to verify")
+	}
+	sig, err := Signature(meta)
+	if err != nil {
+		return err
+	}
+	payload, err := CanonicalPayload(obj)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(payload)
+
+	for _, key := range keys {
+		if ecdsa.VerifyASN1(key, digest[:], sig) {
+			return nil
+		}
+	}
+	return fmt.Errorf("signature does not verify against any configured key")
+}
+
+// Resource verifies a single resource under the policy that matches it,
+// using fallbackKeys when that policy carries no inline keys. A resource no
+// policy's pattern selects is not verified; onNoMatch decides whether that
+// fails, warns or passes.
+func Resource(ctx context.Context, obj runtime.Object, policies []*v1alpha1.VerificationPolicy, fallbackKeys []*ecdsa.PublicKey, onNoMatch NoMatchPolicy) error {
+	meta, ok := obj.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("resource has no metadata to verify")
+	}
+
+	policy, err := MatchPolicy(policies, meta.GetNamespace(), meta.GetName())
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		switch onNoMatch {
+		case FailNoMatch, "":
+			return fmt.Errorf("no VerificationPolicy matches %s/%s", meta.GetNamespace(), meta.GetName())
+		case WarnNoMatch:
+			return &Warning{msg: fmt.Sprintf("no VerificationPolicy matches %s/%s, skipping verification", meta.GetNamespace(), meta.GetName())}
+		case IgnoreNoMatch:
+			return nil
+		default:
+			return fmt.Errorf("unknown no-match policy %q", onNoMatch)
+		}
+	}
+
+	keys, err := policyKeys(policy)
+	if err != nil {
+		return fmt.Errorf("policy %s: %w", policy.Name, err)
+	}
+	if len(keys) == 0 {
+		keys = fallbackKeys
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("policy %s matched but no keys are configured", policy.Name)
+	}
+
+	if err := Verify(ctx, obj, keys); err != nil {
+		if policy.Spec.Mode == v1alpha1.ModeWarn {
+			return &Warning{msg: fmt.Sprintf("policy %s matched but %v, continuing because its mode is warn", policy.Name, err)}
+		}
+		return fmt.Errorf("policy %s matched but %w", policy.Name, err)
+	}
+	return nil
+}
+
+// policyKeys decodes any PEM keys a VerificationPolicy embeds inline via
+// spec.authorities[].key.data.
+func policyKeys(policy *v1alpha1.VerificationPolicy) ([]*ecdsa.PublicKey, error) {
+	var keys []*ecdsa.PublicKey
+	for _, authority := range policy.Spec.Authorities {
+		if authority.Key == nil || authority.Key.Data == "" {
+			continue
+		}
+		block, _ := pem.Decode([]byte(authority.Key.Data))
+		if block == nil {
+			return nil, fmt.Errorf("authority %s: key data is not PEM", authority.Name)
+		}
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("authority %s: parse public key: %w", authority.Name, err)
+		}
+		ecKey, ok := pub.(*ecdsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("authority %s: only ECDSA public keys are supported", authority.Name)
+		}
+		keys = append(keys, ecKey)
+	}
+	return keys, nil
+}
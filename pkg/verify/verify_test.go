@@ -0,0 +1,206 @@
+package verify
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"testing"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMatchPolicy(t *testing.T) {
+	policies := []*v1alpha1.VerificationPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "git-policy"},
+			Spec: v1alpha1.VerificationPolicySpec{
+				Resources: []v1alpha1.ResourcePattern{{Pattern: "^prod/.*"}},
+			},
+		},
+	}
+
+	policy, err := MatchPolicy(policies, "prod", "build")
+	if err != nil {
+		t.Fatalf("MatchPolicy: %v", err)
+	}
+	if policy == nil || policy.Name != "git-policy" {
+		t.Fatalf("MatchPolicy = %v, want git-policy", policy)
+	}
+
+	policy, err = MatchPolicy(policies, "staging", "build")
+	if err != nil {
+		t.Fatalf("MatchPolicy: %v", err)
+	}
+	if policy != nil {
+		t.Fatalf("MatchPolicy = %v, want no match", policy)
+	}
+}
+
+func TestMatchPolicy_InvalidPattern(t *testing.T) {
+	policies := []*v1alpha1.VerificationPolicy{{
+		ObjectMeta: metav1.ObjectMeta{Name: "bad-policy"},
+		Spec:       v1alpha1.VerificationPolicySpec{Resources: []v1alpha1.ResourcePattern{{Pattern: "("}}},
+	}}
+
+	if _, err := MatchPolicy(policies, "ns", "name"); err == nil {
+		t.Fatal("MatchPolicy() err = nil, want an invalid pattern error")
+	}
+}
+
+func TestCanonicalPayload_StripsSignatureAnnotation(t *testing.T) {
+	task := &v1.Task{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "build",
+			Annotations: map[string]string{
+				SignatureAnnotation: "should-not-be-signed-over",
+				"other":             "kept",
+			},
+		},
+	}
+
+	payload, err := CanonicalPayload(task)
+	if err != nil {
+		t.Fatalf("CanonicalPayload: %v", err)
+	}
+	if strings.Contains(string(payload), SignatureAnnotation) {
+		t.Errorf("payload still contains the signature annotation: %s", payload)
+	}
+	if !strings.Contains(string(payload), "other") {
+		t.Errorf("payload dropped an unrelated annotation: %s", payload)
+	}
+}
+
+func generateKey(t *testing.T) (*ecdsa.PrivateKey, *ecdsa.PublicKey) {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return priv, &priv.PublicKey
+}
+
+func sign(t *testing.T, priv *ecdsa.PrivateKey, obj *v1.Task) {
+	t.Helper()
+	payload, err := CanonicalPayload(obj)
+	if err != nil {
+		t.Fatalf("CanonicalPayload: %v", err)
+	}
+	digest := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, priv, digest[:])
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	if obj.Annotations == nil {
+		obj.Annotations = map[string]string{}
+	}
+	obj.Annotations[SignatureAnnotation] = base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestVerify(t *testing.T) {
+	priv, pub := generateKey(t)
+	task := &v1.Task{ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "prod", Annotations: map[string]string{"owner": "platform-team"}}}
+	sign(t, priv, task)
+
+	if err := Verify(context.Background(), task, []*ecdsa.PublicKey{pub}); err != nil {
+		t.Errorf("Verify() = %v, want nil", err)
+	}
+
+	_, otherPub := generateKey(t)
+	if err := Verify(context.Background(), task, []*ecdsa.PublicKey{otherPub}); err == nil {
+		t.Error("Verify() with the wrong key = nil, want an error")
+	}
+}
+
+func policyWithInlineKey(t *testing.T, pub *ecdsa.PublicKey, pattern string) *v1alpha1.VerificationPolicy {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatalf("marshal public key: %v", err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	return &v1alpha1.VerificationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "inline-policy"},
+		Spec: v1alpha1.VerificationPolicySpec{
+			Resources:   []v1alpha1.ResourcePattern{{Pattern: pattern}},
+			Authorities: []v1alpha1.Authority{{Name: "authority", Key: &v1alpha1.KeyRef{Data: string(data)}}},
+		},
+	}
+}
+
+func TestResource_MatchedPolicy(t *testing.T) {
+	priv, pub := generateKey(t)
+	task := &v1.Task{ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "prod", Annotations: map[string]string{"owner": "platform-team"}}}
+	sign(t, priv, task)
+
+	policy := policyWithInlineKey(t, pub, "^prod/.*")
+	err := Resource(context.Background(), task, []*v1alpha1.VerificationPolicy{policy}, nil, FailNoMatch)
+	if err != nil {
+		t.Errorf("Resource() = %v, want nil", err)
+	}
+}
+
+func TestResource_WarnModePolicyFailsOpen(t *testing.T) {
+	_, pub := generateKey(t)
+	task := &v1.Task{ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "prod"}}
+	// task is left unsigned, so verification against pub fails.
+
+	policy := policyWithInlineKey(t, pub, "^prod/.*")
+	policy.Spec.Mode = v1alpha1.ModeWarn
+
+	err := Resource(context.Background(), task, []*v1alpha1.VerificationPolicy{policy}, nil, FailNoMatch)
+	if err == nil {
+		t.Fatal("Resource() with a failing warn-mode policy = nil, want a *Warning")
+	}
+	var warning *Warning
+	if !errors.As(err, &warning) {
+		t.Errorf("Resource() with a failing warn-mode policy err = %v (%T), want a *Warning", err, err)
+	}
+}
+
+func TestResource_EnforceModePolicyFailsClosed(t *testing.T) {
+	_, pub := generateKey(t)
+	task := &v1.Task{ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "prod"}}
+	// task is left unsigned, so verification against pub fails.
+
+	policy := policyWithInlineKey(t, pub, "^prod/.*")
+	policy.Spec.Mode = v1alpha1.ModeEnforce
+
+	err := Resource(context.Background(), task, []*v1alpha1.VerificationPolicy{policy}, nil, FailNoMatch)
+	if err == nil {
+		t.Fatal("Resource() with a failing enforce-mode policy = nil, want an error")
+	}
+	var warning *Warning
+	if errors.As(err, &warning) {
+		t.Error("Resource() with a failing enforce-mode policy returned a *Warning, want a hard error")
+	}
+}
+
+func TestResource_NoMatchPolicies(t *testing.T) {
+	task := &v1.Task{ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "staging"}}
+
+	if err := Resource(context.Background(), task, nil, nil, FailNoMatch); err == nil {
+		t.Error("Resource() with FailNoMatch = nil, want an error")
+	}
+
+	if err := Resource(context.Background(), task, nil, nil, IgnoreNoMatch); err != nil {
+		t.Errorf("Resource() with IgnoreNoMatch = %v, want nil", err)
+	}
+
+	err := Resource(context.Background(), task, nil, nil, WarnNoMatch)
+	if err == nil {
+		t.Fatal("Resource() with WarnNoMatch = nil, want a *Warning")
+	}
+	var warning *Warning
+	if !errors.As(err, &warning) {
+		t.Errorf("Resource() with WarnNoMatch err = %v (%T), want a *Warning", err, err)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/redhat-ai-tools/tekton-genie/pkg/bundle"
+	"github.com/redhat-ai-tools/tekton-genie/pkg/validate"
+)
+
+// runBundle implements `tekton-genie bundle <image-ref>`: pull a Tekton
+// Bundle OCI artifact, decode every object it contains, and validate each
+// one with the same Validate(ctx) pass the file-mode path uses.
+func runBundle(args []string) {
+	if len(args) != 1 {
+		usage()
+		os.Exit(1)
+	}
+	ref := args[0]
+
+	ctx := context.Background()
+	b, err := bundle.Pull(ctx, ref)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to pull bundle %s: %v\n", ref, err)
+		os.Exit(1)
+	}
+
+	validator, err := validate.New(nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to build validator: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("%s (digest %s, %d object(s))\n\n", ref, b.Digest, len(b.Objects))
+
+	failed := 0
+	for _, obj := range b.Objects {
+		result := validator.ValidateStream(fmt.Sprintf("%s/%s", obj.Kind, obj.Name), obj.YAML)
+		status := "PASS"
+		if !result.OK() {
+			status = "FAIL"
+			failed++
+		}
+		fmt.Printf("%-4s  %-20s %s\n", status, obj.Kind, obj.Name)
+		for _, f := range result.Failures {
+			fmt.Printf("        %v\n", f.Err)
+		}
+	}
+
+	if failed > 0 {
+		fmt.Fprintf(os.Stderr, "\n%d/%d object(s) failed validation.\n", failed, len(b.Objects))
+		os.Exit(1)
+	}
+	fmt.Printf("\n✅ all %d object(s) valid.\n", len(b.Objects))
+}
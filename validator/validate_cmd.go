@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/redhat-ai-tools/tekton-genie/pkg/dryrun"
+	"github.com/redhat-ai-tools/tekton-genie/pkg/output"
+	"github.com/redhat-ai-tools/tekton-genie/pkg/resolve"
+	"github.com/redhat-ai-tools/tekton-genie/pkg/validate"
+	"github.com/redhat-ai-tools/tekton-genie/pkg/verify"
+)
+
+// runValidate is the default mode: decode and validate one or more
+// Tekton resource YAML files.
+func runValidate(args []string) {
+	fs := flag.NewFlagSet("tekton-genie", flag.ExitOnError)
+	kinds := fs.String("kinds", "", "comma-separated list of Kinds to validate (default: all supported Kinds)")
+	dryRun := fs.String("dry-run", "", "set to \"server\" to additionally dry-run Create each document against a cluster")
+	kubeconfig := fs.String("kubeconfig", "", "path to a kubeconfig file (default: standard kubeconfig resolution)")
+	kubeContext := fs.String("context", "", "kubeconfig context to use with --dry-run=server")
+	namespace := fs.String("namespace", "default", "namespace to dry-run Create into with --dry-run=server")
+	resolveRefs := fs.Bool("resolve", false, "fetch and validate remote pipelineRef/taskRef resolvers (git, bundles, http, hub; cluster refs are reported as failures)")
+	outputFormat := fs.String("output", "", "set to \"json\" or \"sarif\" for machine-readable results instead of text")
+	verifySigs := fs.Bool("verify", false, "enforce Tekton trusted-resources signature verification")
+	policyPath := fs.String("policy", "", "VerificationPolicy YAML to enforce with --verify")
+	keyPath := fs.String("key", "", "PEM-encoded public key to verify signatures against with --verify, used when a matched policy has no inline key")
+	noMatchPolicy := fs.String("no-match-policy", string(verify.FailNoMatch), "what to do with --verify when no policy matches a resource: fail, warn, or ignore")
+	fs.Usage = usage
+	fs.Parse(args)
+
+	if *outputFormat != "" && *outputFormat != "json" && *outputFormat != "sarif" {
+		fmt.Fprintf(os.Stderr, "❌ Unsupported --output value %q, want \"json\" or \"sarif\"\n", *outputFormat)
+		os.Exit(1)
+	}
+
+	files := fs.Args()
+	if len(files) == 0 {
+		usage()
+		os.Exit(1)
+	}
+
+	var kindFilter []string
+	if *kinds != "" {
+		kindFilter = strings.Split(*kinds, ",")
+	}
+
+	validator, err := validate.New(kindFilter)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to build validator: %v\n", err)
+		os.Exit(1)
+	}
+
+	var client *dryrun.Client
+	if *dryRun != "" {
+		if *dryRun != "server" {
+			fmt.Fprintf(os.Stderr, "❌ Unsupported --dry-run value %q, only \"server\" is supported\n", *dryRun)
+			os.Exit(1)
+		}
+		client, err = dryrun.NewClient(*kubeconfig, *kubeContext)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Failed to build cluster client: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	var policies []*v1alpha1.VerificationPolicy
+	var keys []*ecdsa.PublicKey
+	if *verifySigs {
+		if *policyPath == "" {
+			fmt.Fprintf(os.Stderr, "❌ --verify requires --policy\n")
+			os.Exit(1)
+		}
+		policies, err = verify.LoadPolicies(*policyPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Failed to load policy %s: %v\n", *policyPath, err)
+			os.Exit(1)
+		}
+		if *keyPath != "" {
+			key, err := verify.LoadPublicKey(*keyPath)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "❌ Failed to load key %s: %v\n", *keyPath, err)
+				os.Exit(1)
+			}
+			keys = append(keys, key)
+		}
+	}
+
+	var registry *resolve.Registry
+	if *resolveRefs {
+		registry = resolve.NewRegistry(
+			resolve.GitFetcher{},
+			resolve.BundlesFetcher{},
+			resolve.HTTPFetcher{},
+			resolve.HubFetcher{},
+			nil, // no cluster resolver is configured, so cluster refs fail with a "no resolver configured" error
+		)
+	}
+
+	ctx := context.Background()
+	var (
+		checked  int
+		failures []validate.Failure
+		report   output.Report
+	)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Error reading file: %v\n", err)
+			os.Exit(1)
+		}
+
+		result := validator.ValidateStream(file, data)
+		checked += result.Checked
+		failures = append(failures, result.Failures...)
+		for _, o := range result.Outcomes {
+			report.Add(o.Document.GVK.Kind, o.Document.Name(), o.Document.File, o.Document.Line, o.Err, output.FileConfigSource(file, data))
+		}
+
+		if client == nil && registry == nil && !*verifySigs {
+			continue
+		}
+		docs, _ := validator.Decode(file, data)
+		for _, doc := range docs {
+			if client != nil {
+				if outcome := client.Create(ctx, doc, *namespace); !outcome.OK() {
+					failures = append(failures, validate.Failure{
+						File: doc.File, Line: doc.Line, Kind: doc.GVK.Kind, Name: doc.Name(),
+						Err: serverError(outcome),
+					})
+				}
+			}
+			if registry != nil {
+				resolved := resolveDoc(ctx, registry, validator, doc)
+				failures = append(failures, resolved.failures...)
+				report.Documents = append(report.Documents, resolved.reports...)
+			}
+			if *verifySigs {
+				if err := verify.Resource(ctx, doc.Object, policies, keys, verify.NoMatchPolicy(*noMatchPolicy)); err != nil {
+					var warning *verify.Warning
+					if errors.As(err, &warning) {
+						fmt.Fprintf(os.Stderr, "⚠️  %s:%d: %v\n", doc.File, doc.Line, warning)
+					} else {
+						failures = append(failures, validate.Failure{
+							File: doc.File, Line: doc.Line, Kind: doc.GVK.Kind, Name: doc.Name(),
+							Err: fmt.Errorf("trusted-resources verification: %w", err),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	if *outputFormat != "" {
+		writeReport(&report, *outputFormat, len(failures) > 0)
+		return
+	}
+
+	if len(failures) == 0 {
+		fmt.Printf("✅ %d document(s) valid.\n", checked)
+		return
+	}
+
+	for _, f := range failures {
+		fmt.Fprintf(os.Stderr, "❌ %s\n", f)
+	}
+	fmt.Fprintf(os.Stderr, "%d/%d document(s) failed validation.\n", len(failures), checked)
+	os.Exit(1)
+}
+
+// writeReport renders report in the requested format and exits non-zero if
+// any document failed, mirroring the text path's exit code contract. failed
+// carries failures the report has no row for (decode errors, dry-run
+// rejections, signature verification), so those fail the run too.
+func writeReport(report *output.Report, format string, failed bool) {
+	var err error
+	switch format {
+	case "json":
+		err = report.WriteJSON(os.Stdout)
+	case "sarif":
+		err = report.WriteSARIF(os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to write %s output: %v\n", format, err)
+		os.Exit(1)
+	}
+	if failed || !report.OK() {
+		os.Exit(1)
+	}
+}
+
+// serverError renders a dry-run Outcome's failure, including any field-level
+// causes the API server reported, as a single error for Failure.String().
+func serverError(o *dryrun.Outcome) error {
+	msg := fmt.Sprintf("server dry-run: %v", o.Err)
+	if o.Retryable {
+		msg += " (retryable)"
+	}
+	for _, fe := range o.FieldErrors {
+		msg += fmt.Sprintf("\n    %s: %s", fe.JSONPath, fe.Message)
+	}
+	return fmt.Errorf("%s", msg)
+}
+
+// resolvedReport is the outcome of resolving and validating one document's
+// remote pipelineRef/taskRef: the failures (if any) plus report rows for
+// --output, which need their own resolver-sourced ConfigSource.
+type resolvedReport struct {
+	failures []validate.Failure
+	reports  []output.DocumentReport
+}
+
+// resolveDoc fetches and validates whatever resource doc's resolver refers
+// to, so a PipelineRun/TaskRun with a remote pipelineRef/taskRef gets the
+// referenced Pipeline/Task checked too, not just the run envelope.
+func resolveDoc(ctx context.Context, registry *resolve.Registry, validator *validate.Validator, doc validate.Document) resolvedReport {
+	switch run := doc.Object.(type) {
+	case *v1.PipelineRun:
+		if run.Spec.PipelineRef == nil || run.Spec.PipelineRef.Resolver == "" {
+			return resolvedReport{}
+		}
+		return resolveRef(ctx, registry, validator, doc, "pipelineRef", run.Spec.PipelineRef.ResolverRef, func(obj runtime.Object) error {
+			pipeline, ok := obj.(*v1.Pipeline)
+			if !ok {
+				return nil
+			}
+			return resolve.ValidateParams(pipeline.Spec.Params, run.Spec.Params)
+		})
+	case *v1.TaskRun:
+		if run.Spec.TaskRef == nil || run.Spec.TaskRef.Resolver == "" {
+			return resolvedReport{}
+		}
+		return resolveRef(ctx, registry, validator, doc, "taskRef", run.Spec.TaskRef.ResolverRef, func(obj runtime.Object) error {
+			task, ok := obj.(*v1.Task)
+			if !ok {
+				return nil
+			}
+			return resolve.ValidateParams(task.Spec.Params, run.Spec.Params)
+		})
+	default:
+		return resolvedReport{}
+	}
+}
+
+// resolveRef fetches ref (a PipelineRun's pipelineRef or a TaskRun's
+// taskRef) via registry, validates every document the fetch returns, and
+// runs checkParams against each resolved object so required params the
+// resolved Pipeline/Task declares without a default, and the run doesn't
+// supply, are caught too.
+func resolveRef(ctx context.Context, registry *resolve.Registry, validator *validate.Validator, doc validate.Document, refField string, ref v1.ResolverRef, checkParams func(runtime.Object) error) resolvedReport {
+	req := resolve.NewRequest(ref)
+	data, source, err := registry.Resolve(ctx, req)
+	if err != nil {
+		return resolvedReport{failures: []validate.Failure{{
+			File: doc.File, Line: doc.Line, Kind: doc.GVK.Kind, Name: doc.Name(),
+			Err: fmt.Errorf("resolve %s: %w", refField, err),
+		}}}
+	}
+
+	configSource := output.ResolverConfigSource(source.Resolver, source.URI, source.Revision)
+	resolved := validator.ValidateStream(source.String(), data)
+	out := resolvedReport{failures: resolved.Failures}
+	for _, o := range resolved.Outcomes {
+		row := output.DocumentReport{
+			Kind: o.Document.GVK.Kind, Name: o.Document.Name(), File: o.Document.File, Line: o.Document.Line,
+			Status:       okStatus(o.Err),
+			ConfigSource: configSource,
+		}
+		if o.Err != nil {
+			row.Error = o.Err.Error()
+			row.FieldIssues = output.FieldIssues(o.Err)
+		}
+		out.reports = append(out.reports, row)
+		if err := checkParams(o.Document.Object); err != nil {
+			out.failures = append(out.failures, validate.Failure{File: doc.File, Line: doc.Line, Kind: doc.GVK.Kind, Name: doc.Name(), Err: fmt.Errorf("params for resolved %s: %w", o.Document.GVK.Kind, err)})
+		}
+	}
+	return out
+}
+
+func okStatus(err error) string {
+	if err != nil {
+		return "FAIL"
+	}
+	return "PASS"
+}